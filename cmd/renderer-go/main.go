@@ -0,0 +1,85 @@
+// Command renderer-go generates the pump package under pump/generated
+// from the embedded IDL in the idl package.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/codama-idl/renderers-go/idl"
+	"github.com/codama-idl/renderers-go/internal/renderer"
+)
+
+const outDir = "pump/generated"
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "renderer-go:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	i, err := idl.Load()
+	if err != nil {
+		return err
+	}
+
+	files := map[string]func() (string, error){
+		"program.go":      func() (string, error) { return renderer.Program(i) },
+		"accounts.go":     func() (string, error) { return renderer.Accounts(i) },
+		"instructions.go": func() (string, error) { return renderer.Instructions(i) },
+		"events.go":       func() (string, error) { return renderer.Events(i) },
+		"fetch.go":        func() (string, error) { return renderer.Fetch(i) },
+		"subscribe.go":    func() (string, error) { return renderer.Subscribe(i) },
+		"pda.go":          func() (string, error) { return renderer.PDA(i) },
+	}
+
+	for name, render := range files {
+		if err := writeRendered(name, render); err != nil {
+			return fmt.Errorf("rendering %s: %w", name, err)
+		}
+	}
+
+	for _, ix := range i.Instructions {
+		name := fmt.Sprintf("instruction_%s.go", toSnakeCase(ix.GoName))
+		ix := ix
+		if err := writeRendered(name, func() (string, error) { return renderer.InstructionBuilder(ix) }); err != nil {
+			return fmt.Errorf("rendering %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func writeRendered(name string, render func() (string, error)) error {
+	src, err := render()
+	if err != nil {
+		return err
+	}
+
+	formatted, err := renderer.Format(src)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(outDir, name), formatted, 0o644)
+}
+
+// toSnakeCase converts an exported Go identifier like "Buy" to the
+// snake_case form used in per-instruction file names, e.g. "buy".
+func toSnakeCase(s string) string {
+	var out []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' {
+			if i > 0 {
+				out = append(out, '_')
+			}
+			c = c - 'A' + 'a'
+		}
+		out = append(out, c)
+	}
+	return string(out)
+}
@@ -0,0 +1,254 @@
+// Package idl holds the Codama-format Anchor IDL for the pump.fun
+// program and parses it into the model internal/renderer consumes to
+// generate the pump package.
+package idl
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	ag_binary "github.com/gagliardetto/binary"
+)
+
+//go:embed pump.json
+var pumpJSON []byte
+
+// raw* types mirror the on-disk JSON IDL shape.
+
+type rawField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Docs string `json:"docs"`
+}
+
+type rawSeed struct {
+	Kind  string `json:"kind"`  // "const" or "account"
+	Value string `json:"value"` // set when Kind == "const"
+	Name  string `json:"name"`  // set when Kind == "account"
+}
+
+type rawPDA struct {
+	Seeds []rawSeed `json:"seeds"`
+}
+
+type rawAccount struct {
+	Name   string     `json:"name"`
+	Docs   string     `json:"docs"`
+	Fields []rawField `json:"fields"`
+	PDA    *rawPDA    `json:"pda"`
+}
+
+type rawInstructionAccount struct {
+	Name     string `json:"name"`
+	Writable bool   `json:"writable"`
+	Signer   bool   `json:"signer"`
+}
+
+type rawInstruction struct {
+	Name     string                  `json:"name"`
+	Docs     string                  `json:"docs"`
+	Args     []rawField              `json:"args"`
+	Accounts []rawInstructionAccount `json:"accounts"`
+}
+
+type rawEvent struct {
+	Name   string     `json:"name"`
+	Docs   string     `json:"docs"`
+	Fields []rawField `json:"fields"`
+}
+
+type rawIDL struct {
+	Name         string           `json:"name"`
+	Accounts     []rawAccount     `json:"accounts"`
+	Instructions []rawInstruction `json:"instructions"`
+	Events       []rawEvent       `json:"events"`
+}
+
+// Field is a Borsh-encoded struct field, with its IDL type already
+// mapped to the Go type the renderer should emit.
+type Field struct {
+	// GoName is the exported Go field/parameter name, e.g. "VirtualTokenReserves".
+	GoName string
+	// IDLName is the field's name as declared in the IDL, e.g. "virtualTokenReserves".
+	IDLName string
+	// GoType is the Go type used to represent the field, e.g. "uint64".
+	GoType string
+	Docs   string
+}
+
+// Seed is one element of a PDA's seed list.
+type Seed struct {
+	// Const holds the literal seed bytes, set when Account == "".
+	Const []byte
+	// Account is the name of the function parameter this seed is derived
+	// from (its bytes are used directly), set when non-empty.
+	Account string
+}
+
+// Account is an IDL account type, including the Borsh discriminator
+// written at the start of every instance and, if any, the seeds used to
+// derive its PDA.
+type Account struct {
+	Name          string
+	Docs          string
+	Discriminator [8]byte
+	Fields        []Field
+	Seeds         []Seed // nil if the account isn't PDA-derived
+}
+
+// InstructionAccount is one entry in an instruction's ordered account
+// list.
+type InstructionAccount struct {
+	// GoName is the exported name used in e.g. SetGlobalAccount.
+	GoName string
+	// IDLName is the account's name as declared in the IDL, e.g. "feeRecipient".
+	IDLName  string
+	Writable bool
+	Signer   bool
+}
+
+// Instruction is an IDL instruction: its Anchor discriminator, its
+// Borsh-encoded argument list, and the ordered accounts it expects.
+type Instruction struct {
+	// GoName is the exported Go type name, e.g. "Buy".
+	GoName string
+	// IDLName is the instruction's name as declared in the IDL, e.g. "buy".
+	IDLName       string
+	Docs          string
+	Discriminator [8]byte
+	Args          []Field
+	Accounts      []InstructionAccount
+}
+
+// Event is an IDL event: its Anchor discriminator and Borsh-encoded
+// field list.
+type Event struct {
+	Name          string
+	Docs          string
+	Discriminator [8]byte
+	Fields        []Field
+}
+
+// IDL is the parsed, renderer-ready form of an Anchor program's IDL.
+type IDL struct {
+	Name         string
+	Accounts     []Account
+	Instructions []Instruction
+	Events       []Event
+}
+
+// idlTypeToGoType maps an IDL primitive type name to the Go type the
+// renderer emits for it.
+func idlTypeToGoType(idlType string) (string, error) {
+	switch idlType {
+	case "u64":
+		return "uint64", nil
+	case "i64":
+		return "int64", nil
+	case "bool":
+		return "bool", nil
+	case "publicKey":
+		return "ag_solanago.PublicKey", nil
+	default:
+		return "", fmt.Errorf("idl: unsupported field type %q", idlType)
+	}
+}
+
+func convertFields(raw []rawField) ([]Field, error) {
+	out := make([]Field, 0, len(raw))
+	for _, f := range raw {
+		goType, err := idlTypeToGoType(f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", f.Name, err)
+		}
+		out = append(out, Field{
+			GoName:  ag_binary.ToPascalCase(f.Name),
+			IDLName: f.Name,
+			GoType:  goType,
+			Docs:    f.Docs,
+		})
+	}
+	return out, nil
+}
+
+func convertSeeds(pda *rawPDA) []Seed {
+	if pda == nil {
+		return nil
+	}
+	out := make([]Seed, 0, len(pda.Seeds))
+	for _, s := range pda.Seeds {
+		switch s.Kind {
+		case "const":
+			out = append(out, Seed{Const: []byte(s.Value)})
+		case "account":
+			out = append(out, Seed{Account: s.Name})
+		}
+	}
+	return out
+}
+
+// Load parses the embedded pump.fun IDL into its renderer-ready form,
+// computing each account/instruction/event's Anchor discriminator from
+// its IDL name along the way.
+func Load() (*IDL, error) {
+	var raw rawIDL
+	if err := json.Unmarshal(pumpJSON, &raw); err != nil {
+		return nil, fmt.Errorf("idl: parsing embedded IDL: %w", err)
+	}
+
+	out := &IDL{Name: raw.Name}
+
+	for _, a := range raw.Accounts {
+		fields, err := convertFields(a.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("account %q: %w", a.Name, err)
+		}
+		out.Accounts = append(out.Accounts, Account{
+			Name:          a.Name,
+			Docs:          a.Docs,
+			Discriminator: [8]byte(ag_binary.SighashAccount(a.Name)),
+			Fields:        fields,
+			Seeds:         convertSeeds(a.PDA),
+		})
+	}
+
+	for _, ix := range raw.Instructions {
+		args, err := convertFields(ix.Args)
+		if err != nil {
+			return nil, fmt.Errorf("instruction %q: %w", ix.Name, err)
+		}
+		accounts := make([]InstructionAccount, 0, len(ix.Accounts))
+		for _, a := range ix.Accounts {
+			accounts = append(accounts, InstructionAccount{
+				GoName:   ag_binary.ToPascalCase(a.Name),
+				IDLName:  a.Name,
+				Writable: a.Writable,
+				Signer:   a.Signer,
+			})
+		}
+		out.Instructions = append(out.Instructions, Instruction{
+			GoName:        ag_binary.ToPascalCase(ix.Name),
+			IDLName:       ix.Name,
+			Docs:          ix.Docs,
+			Discriminator: [8]byte(ag_binary.SighashInstruction(ix.Name)),
+			Args:          args,
+			Accounts:      accounts,
+		})
+	}
+
+	for _, ev := range raw.Events {
+		fields, err := convertFields(ev.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("event %q: %w", ev.Name, err)
+		}
+		out.Events = append(out.Events, Event{
+			Name:          ev.Name,
+			Docs:          ev.Docs,
+			Discriminator: [8]byte(ag_binary.Sighash("event", ev.Name)),
+			Fields:        fields,
+		})
+	}
+
+	return out, nil
+}
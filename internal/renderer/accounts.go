@@ -0,0 +1,76 @@
+package renderer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/codama-idl/renderers-go/idl"
+)
+
+// Accounts renders pump/generated/accounts.go: one discriminator, one
+// struct, and one Marshal/UnmarshalWithDecoder pair per account the IDL
+// declares.
+func Accounts(i *idl.IDL) (string, error) {
+	var b strings.Builder
+
+	b.WriteString("package pump\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\t\"fmt\"\n\n")
+	b.WriteString("\tag_binary \"github.com/gagliardetto/binary\"\n")
+	if usesPublicKey(i.Accounts) {
+		b.WriteString("\tag_solanago \"github.com/gagliardetto/solana-go\"\n")
+	}
+	b.WriteString(")\n\n")
+
+	for _, acc := range i.Accounts {
+		fmt.Fprintf(&b, "// Account_%s is the 8-byte Anchor discriminator written at the\n", acc.Name)
+		fmt.Fprintf(&b, "// start of every serialized %s account.\n", acc.Name)
+		fmt.Fprintf(&b, "var Account_%s = %s\n\n", acc.Name, typeIDLiteral(acc.Discriminator))
+
+		if acc.Docs != "" {
+			fmt.Fprintf(&b, "// %s %s\n", acc.Name, acc.Docs)
+		}
+		fmt.Fprintf(&b, "type %s struct {\n", acc.Name)
+		for _, f := range acc.Fields {
+			fmt.Fprintf(&b, "\t%s %s\n", f.GoName, f.GoType)
+		}
+		b.WriteString("}\n\n")
+
+		fmt.Fprintf(&b, "func (obj %s) MarshalWithEncoder(encoder *ag_binary.Encoder) (err error) {\n", acc.Name)
+		fmt.Fprintf(&b, "\t// Write account discriminator:\n")
+		fmt.Fprintf(&b, "\terr = encoder.WriteBytes(Account_%s[:], false)\n", acc.Name)
+		b.WriteString("\tif err != nil {\n\t\treturn err\n\t}\n")
+		for _, f := range acc.Fields {
+			fmt.Fprintf(&b, "\terr = encoder.Encode(obj.%s)\n", f.GoName)
+			b.WriteString("\tif err != nil {\n\t\treturn err\n\t}\n")
+		}
+		b.WriteString("\treturn nil\n}\n\n")
+
+		fmt.Fprintf(&b, "func (obj *%s) UnmarshalWithDecoder(decoder *ag_binary.Decoder) (err error) {\n", acc.Name)
+		b.WriteString("\t// Read and check account discriminator:\n\t{\n")
+		b.WriteString("\t\tdiscriminator, err := decoder.ReadTypeID()\n")
+		b.WriteString("\t\tif err != nil {\n\t\t\treturn err\n\t\t}\n")
+		fmt.Fprintf(&b, "\t\tif !discriminator.Equal(Account_%s[:]) {\n", acc.Name)
+		b.WriteString("\t\t\treturn fmt.Errorf(\n")
+		b.WriteString("\t\t\t\t\"wrong discriminator: wanted %s, got %s\",\n")
+		fmt.Fprintf(&b, "\t\t\t\tAccount_%s,\n", acc.Name)
+		b.WriteString("\t\t\t\tdiscriminator,\n\t\t\t)\n\t\t}\n\t}\n")
+		for _, f := range acc.Fields {
+			fmt.Fprintf(&b, "\tif err = decoder.Decode(&obj.%s); err != nil {\n\t\treturn err\n\t}\n", f.GoName)
+		}
+		b.WriteString("\treturn nil\n}\n\n")
+	}
+
+	return b.String(), nil
+}
+
+func usesPublicKey(accounts []idl.Account) bool {
+	for _, acc := range accounts {
+		for _, f := range acc.Fields {
+			if f.GoType == "ag_solanago.PublicKey" {
+				return true
+			}
+		}
+	}
+	return false
+}
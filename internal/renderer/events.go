@@ -0,0 +1,76 @@
+package renderer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/codama-idl/renderers-go/idl"
+)
+
+// Events renders pump/generated/events.go: one discriminator, one
+// struct, one UnmarshalWithDecoder, and the DecodeEvent dispatcher
+// covering every event the IDL declares.
+func Events(i *idl.IDL) (string, error) {
+	var b strings.Builder
+
+	b.WriteString("package pump\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\t\"fmt\"\n\n")
+	b.WriteString("\tag_binary \"github.com/gagliardetto/binary\"\n")
+	if usesPublicKeyFields(i.Events) {
+		b.WriteString("\tag_solanago \"github.com/gagliardetto/solana-go\"\n")
+	}
+	b.WriteString(")\n\n")
+
+	b.WriteString("// Event discriminators, matching the program's Anchor event sighashes.\n")
+	b.WriteString("var (\n")
+	for _, ev := range i.Events {
+		fmt.Fprintf(&b, "\tEvent_%s = %s\n", strings.TrimSuffix(ev.Name, "Event"), typeIDLiteral(ev.Discriminator))
+	}
+	b.WriteString(")\n\n")
+
+	for _, ev := range i.Events {
+		if ev.Docs != "" {
+			fmt.Fprintf(&b, "// %s %s\n", ev.Name, ev.Docs)
+		}
+		fmt.Fprintf(&b, "type %s struct {\n", ev.Name)
+		for _, f := range ev.Fields {
+			fmt.Fprintf(&b, "\t%s %s\n", f.GoName, f.GoType)
+		}
+		b.WriteString("}\n\n")
+
+		fmt.Fprintf(&b, "func (obj *%s) UnmarshalWithDecoder(decoder *ag_binary.Decoder) (err error) {\n", ev.Name)
+		for _, f := range ev.Fields {
+			fmt.Fprintf(&b, "\tif err = decoder.Decode(&obj.%s); err != nil {\n\t\treturn err\n\t}\n", f.GoName)
+		}
+		b.WriteString("\treturn nil\n}\n\n")
+	}
+
+	b.WriteString("// DecodeEvent decodes a single raw \"Program data:\" payload into\n")
+	b.WriteString("// whichever typed event its 8-byte discriminator identifies.\n")
+	b.WriteString("func DecodeEvent(data []byte) (interface{}, error) {\n")
+	b.WriteString("\tdecoder := ag_binary.NewBorshDecoder(data)\n")
+	b.WriteString("\tdiscriminator, err := decoder.ReadTypeID()\n")
+	b.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+	b.WriteString("\tswitch discriminator {\n")
+	for _, ev := range i.Events {
+		fmt.Fprintf(&b, "\tcase Event_%s:\n", strings.TrimSuffix(ev.Name, "Event"))
+		fmt.Fprintf(&b, "\t\tvar ev %s\n", ev.Name)
+		b.WriteString("\t\tif err := ev.UnmarshalWithDecoder(decoder); err != nil {\n\t\t\treturn nil, err\n\t\t}\n")
+		b.WriteString("\t\treturn &ev, nil\n")
+	}
+	b.WriteString("\tdefault:\n\t\treturn nil, fmt.Errorf(\"unknown event discriminator: %s\", discriminator)\n\t}\n}\n")
+
+	return b.String(), nil
+}
+
+func usesPublicKeyFields(events []idl.Event) bool {
+	for _, ev := range events {
+		for _, f := range ev.Fields {
+			if f.GoType == "ag_solanago.PublicKey" {
+				return true
+			}
+		}
+	}
+	return false
+}
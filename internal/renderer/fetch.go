@@ -0,0 +1,70 @@
+package renderer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/codama-idl/renderers-go/idl"
+)
+
+// Fetch renders pump/generated/fetch.go: a batched GetMultipleAccounts
+// fetch-and-decode helper and a GetProgramAccounts-with-memcmp-filter
+// helper per account the IDL declares.
+func Fetch(i *idl.IDL) (string, error) {
+	var b strings.Builder
+
+	b.WriteString("package pump\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\t\"context\"\n\n")
+	b.WriteString("\tag_binary \"github.com/gagliardetto/binary\"\n")
+	b.WriteString("\tag_solanago \"github.com/gagliardetto/solana-go\"\n")
+	b.WriteString("\t\"github.com/gagliardetto/solana-go/rpc\"\n")
+	b.WriteString(")\n\n")
+
+	b.WriteString("// getMultipleAccountsMaxBatch is the largest number of pubkeys the\n")
+	b.WriteString("// Solana RPC's getMultipleAccounts method will accept in a single call.\n")
+	b.WriteString("const getMultipleAccountsMaxBatch = 100\n\n")
+
+	for _, acc := range i.Accounts {
+		fmt.Fprintf(&b, "// FetchMultiple%ss fetches and decodes the %s\n", acc.Name, acc.Name)
+		b.WriteString("// accounts at pubkeys, chunking the underlying GetMultipleAccounts calls\n")
+		b.WriteString("// to respect the RPC's 100-account limit. The result slice has the same\n")
+		b.WriteString("// length and order as pubkeys; entries for accounts that don't exist are\n")
+		b.WriteString("// nil.\n")
+		fmt.Fprintf(&b, "func FetchMultiple%ss(ctx context.Context, client *rpc.Client, pubkeys []ag_solanago.PublicKey) ([]*%s, error) {\n", acc.Name, acc.Name)
+		fmt.Fprintf(&b, "\tout := make([]*%s, 0, len(pubkeys))\n\n", acc.Name)
+		b.WriteString("\tfor start := 0; start < len(pubkeys); start += getMultipleAccountsMaxBatch {\n")
+		b.WriteString("\t\tend := start + getMultipleAccountsMaxBatch\n")
+		b.WriteString("\t\tif end > len(pubkeys) {\n\t\t\tend = len(pubkeys)\n\t\t}\n\n")
+		b.WriteString("\t\tresp, err := client.GetMultipleAccounts(ctx, pubkeys[start:end]...)\n")
+		b.WriteString("\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n\n")
+		b.WriteString("\t\tfor _, acc := range resp.Value {\n")
+		b.WriteString("\t\t\tif acc == nil {\n\t\t\t\tout = append(out, nil)\n\t\t\t\tcontinue\n\t\t\t}\n\n")
+		fmt.Fprintf(&b, "\t\t\tvar dst %s\n", acc.Name)
+		b.WriteString("\t\t\tdecoder := ag_binary.NewBorshDecoder(acc.Data.GetBinary())\n")
+		b.WriteString("\t\t\tif err := dst.UnmarshalWithDecoder(decoder); err != nil {\n\t\t\t\treturn nil, err\n\t\t\t}\n")
+		b.WriteString("\t\t\tout = append(out, &dst)\n\t\t}\n\t}\n\n")
+		b.WriteString("\treturn out, nil\n}\n\n")
+
+		fmt.Fprintf(&b, "// Fetch%sProgramAccounts fetches and decodes every %s account\n", acc.Name, acc.Name)
+		b.WriteString("// owned by ProgramID, using a memcmp filter on the account discriminator\n")
+		b.WriteString("// so the RPC node does the filtering instead of returning the whole\n")
+		b.WriteString("// program's account set.\n")
+		fmt.Fprintf(&b, "func Fetch%sProgramAccounts(ctx context.Context, client *rpc.Client) ([]*%s, error) {\n", acc.Name, acc.Name)
+		b.WriteString("\tresp, err := client.GetProgramAccountsWithOpts(ctx, ProgramID, &rpc.GetProgramAccountsOpts{\n")
+		b.WriteString("\t\tFilters: []rpc.RPCFilter{\n\t\t\t{\n\t\t\t\tMemcmp: &rpc.RPCFilterMemcmp{\n")
+		b.WriteString("\t\t\t\t\tOffset: 0,\n")
+		fmt.Fprintf(&b, "\t\t\t\t\tBytes:  Account_%s[:],\n", acc.Name)
+		b.WriteString("\t\t\t\t},\n\t\t\t},\n\t\t},\n\t})\n")
+		b.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+		fmt.Fprintf(&b, "\tout := make([]*%s, 0, len(resp))\n", acc.Name)
+		b.WriteString("\tfor _, keyedAcc := range resp {\n")
+		fmt.Fprintf(&b, "\t\tvar dst %s\n", acc.Name)
+		b.WriteString("\t\tdecoder := ag_binary.NewBorshDecoder(keyedAcc.Account.Data.GetBinary())\n")
+		b.WriteString("\t\tif err := dst.UnmarshalWithDecoder(decoder); err != nil {\n\t\t\treturn nil, err\n\t\t}\n")
+		b.WriteString("\t\tout = append(out, &dst)\n\t}\n\n")
+		b.WriteString("\treturn out, nil\n}\n\n")
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,206 @@
+package renderer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/codama-idl/renderers-go/idl"
+)
+
+// Instructions renders pump/generated/instructions.go: the instruction
+// discriminators, the variant registry, and the Instruction wrapper type
+// shared by every instruction in the IDL.
+func Instructions(i *idl.IDL) (string, error) {
+	var b strings.Builder
+
+	b.WriteString("package pump\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\t\"bytes\"\n")
+	b.WriteString("\t\"fmt\"\n\n")
+	b.WriteString("\tag_binary \"github.com/gagliardetto/binary\"\n")
+	b.WriteString("\tag_solanago \"github.com/gagliardetto/solana-go\"\n")
+	b.WriteString(")\n\n")
+
+	b.WriteString("// Instruction discriminators, matching the program's Anchor instruction\n")
+	b.WriteString("// sighashes.\n")
+	b.WriteString("var (\n")
+	for _, ix := range i.Instructions {
+		fmt.Fprintf(&b, "\tInstruction_%s = %s\n", ix.GoName, typeIDLiteral(ix.Discriminator))
+	}
+	b.WriteString(")\n\n")
+
+	b.WriteString("// InstructionImplDef maps each instruction discriminator to the Go type\n")
+	b.WriteString("// that implements it, so Instruction can encode/decode any variant.\n")
+	b.WriteString("//\n")
+	b.WriteString("// The variant names must match the IDL's own instruction names, not the\n")
+	b.WriteString("// exported Go type names: AnchorTypeIDEncoding hashes the name as given,\n")
+	b.WriteString("// with no case conversion, to get each variant's 8-byte discriminator.\n")
+	b.WriteString("var InstructionImplDef = ag_binary.NewVariantDefinition(ag_binary.AnchorTypeIDEncoding, []ag_binary.VariantType{\n")
+	for _, ix := range i.Instructions {
+		fmt.Fprintf(&b, "\t{Name: %q, Type: (*%s)(nil)},\n", ix.IDLName, ix.GoName)
+	}
+	b.WriteString("})\n\n")
+
+	b.WriteString("// Instruction wraps one of the program's instruction variants so it\n")
+	b.WriteString("// satisfies solana.Instruction.\n")
+	b.WriteString("type Instruction struct {\n\tag_binary.BaseVariant\n}\n\n")
+
+	b.WriteString("func (inst *Instruction) ProgramID() ag_solanago.PublicKey {\n\treturn ProgramID\n}\n\n")
+
+	b.WriteString("func (inst *Instruction) Accounts() []*ag_solanago.AccountMeta {\n")
+	b.WriteString("\treturn inst.Impl.(ag_solanago.AccountsGettable).GetAccounts()\n}\n\n")
+
+	b.WriteString("func (inst *Instruction) Data() ([]byte, error) {\n")
+	b.WriteString("\tbuf := new(bytes.Buffer)\n")
+	b.WriteString("\tif err := ag_binary.NewBorshEncoder(buf).Encode(inst); err != nil {\n")
+	b.WriteString("\t\treturn nil, fmt.Errorf(\"unable to encode instruction: %w\", err)\n\t}\n")
+	b.WriteString("\treturn buf.Bytes(), nil\n}\n\n")
+
+	b.WriteString("func (inst *Instruction) MarshalWithEncoder(encoder *ag_binary.Encoder) error {\n")
+	b.WriteString("\tif err := encoder.WriteBytes(inst.TypeID.Bytes(), false); err != nil {\n")
+	b.WriteString("\t\treturn fmt.Errorf(\"unable to write variant type: %w\", err)\n\t}\n")
+	b.WriteString("\treturn encoder.Encode(inst.Impl)\n}\n\n")
+
+	b.WriteString("func (inst *Instruction) UnmarshalWithDecoder(decoder *ag_binary.Decoder) error {\n")
+	b.WriteString("\treturn inst.BaseVariant.UnmarshalBinaryVariant(decoder, InstructionImplDef)\n}\n\n")
+
+	b.WriteString("// DecodeInstruction decodes raw Anchor instruction data against\n")
+	b.WriteString("// InstructionImplDef and attaches accounts to the resulting variant.\n")
+	b.WriteString("func DecodeInstruction(accounts []*ag_solanago.AccountMeta, data []byte) (*Instruction, error) {\n")
+	b.WriteString("\tinst := new(Instruction)\n")
+	b.WriteString("\tif err := ag_binary.NewBorshDecoder(data).Decode(inst); err != nil {\n")
+	b.WriteString("\t\treturn nil, fmt.Errorf(\"unable to decode instruction: %w\", err)\n\t}\n")
+	b.WriteString("\tif v, ok := inst.Impl.(ag_solanago.AccountsSettable); ok {\n")
+	b.WriteString("\t\tif err := v.SetAccounts(accounts); err != nil {\n")
+	b.WriteString("\t\t\treturn nil, fmt.Errorf(\"unable to set accounts: %w\", err)\n\t\t}\n\t}\n")
+	b.WriteString("\treturn inst, nil\n}\n")
+
+	return b.String(), nil
+}
+
+// InstructionBuilder renders pump/generated/instruction_<name>.go: a
+// fluent builder for a single instruction, mirroring the style of
+// solana-go's own generated clients.
+func InstructionBuilder(ix idl.Instruction) (string, error) {
+	var b strings.Builder
+
+	b.WriteString("package pump\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\t\"errors\"\n")
+	b.WriteString("\t\"fmt\"\n\n")
+	b.WriteString("\tag_binary \"github.com/gagliardetto/binary\"\n")
+	b.WriteString("\tag_solanago \"github.com/gagliardetto/solana-go\"\n")
+	b.WriteString("\tag_format \"github.com/gagliardetto/solana-go/text/format\"\n")
+	b.WriteString("\tag_treeout \"github.com/gagliardetto/treeout\"\n")
+	b.WriteString(")\n\n")
+
+	if ix.Docs != "" {
+		fmt.Fprintf(&b, "// %s %s\n", ix.GoName, ix.Docs)
+	}
+	fmt.Fprintf(&b, "type %s struct {\n", ix.GoName)
+	for _, arg := range ix.Args {
+		if arg.Docs != "" {
+			fmt.Fprintf(&b, "\t// %s is %s\n", arg.GoName, lowerFirst(arg.Docs))
+		}
+		fmt.Fprintf(&b, "\t%s *%s\n", arg.GoName, arg.GoType)
+	}
+	b.WriteString("\n")
+	for idx, acc := range ix.Accounts {
+		flags := accountFlags(acc)
+		fmt.Fprintf(&b, "\t// [%d] = [%s] %s\n", idx, flags, acc.GoName)
+	}
+	b.WriteString("\tag_solanago.AccountMetaSlice `bin:\"-\"`\n}\n\n")
+
+	fmt.Fprintf(&b, "// New%sInstructionBuilder creates a new %s instruction builder.\n", ix.GoName, ix.GoName)
+	fmt.Fprintf(&b, "func New%sInstructionBuilder() *%s {\n", ix.GoName, ix.GoName)
+	fmt.Fprintf(&b, "\treturn &%s{\n\t\tAccountMetaSlice: make(ag_solanago.AccountMetaSlice, %d),\n\t}\n}\n\n", ix.GoName, len(ix.Accounts))
+
+	for _, arg := range ix.Args {
+		paramName := lowerFirst(arg.GoName)
+		fmt.Fprintf(&b, "func (inst *%s) Set%s(%s %s) *%s {\n", ix.GoName, arg.GoName, paramName, arg.GoType, ix.GoName)
+		fmt.Fprintf(&b, "\tinst.%s = &%s\n\treturn inst\n}\n\n", arg.GoName, paramName)
+	}
+
+	for idx, acc := range ix.Accounts {
+		paramName := lowerFirst(acc.GoName)
+		fmt.Fprintf(&b, "func (inst *%s) Set%sAccount(%s ag_solanago.PublicKey) *%s {\n", ix.GoName, acc.GoName, paramName, ix.GoName)
+		fmt.Fprintf(&b, "\tinst.AccountMetaSlice[%d] = %s\n\treturn inst\n}\n\n", idx, accountMetaExpr(paramName, acc))
+	}
+
+	fmt.Fprintf(&b, "func (inst %s) Build() *Instruction {\n", ix.GoName)
+	b.WriteString("\treturn &Instruction{\n\t\tBaseVariant: ag_binary.BaseVariant{\n")
+	b.WriteString("\t\t\tImpl:   inst,\n")
+	fmt.Fprintf(&b, "\t\t\tTypeID: Instruction_%s,\n", ix.GoName)
+	b.WriteString("\t\t},\n\t}\n}\n\n")
+
+	b.WriteString("// ValidateAndBuild validates the instruction and, if everything required\n")
+	b.WriteString("// was set, returns the built Instruction.\n")
+	fmt.Fprintf(&b, "func (inst %s) ValidateAndBuild() (*Instruction, error) {\n", ix.GoName)
+	b.WriteString("\tif err := inst.Validate(); err != nil {\n\t\treturn nil, err\n\t}\n")
+	b.WriteString("\treturn inst.Build(), nil\n}\n\n")
+
+	fmt.Fprintf(&b, "func (inst *%s) Validate() error {\n", ix.GoName)
+	for _, arg := range ix.Args {
+		fmt.Fprintf(&b, "\tif inst.%s == nil {\n\t\treturn errors.New(%q)\n\t}\n", arg.GoName, arg.GoName+" parameter is not set")
+	}
+	b.WriteString("\tfor accIndex, acc := range inst.AccountMetaSlice {\n")
+	b.WriteString("\t\tif acc == nil {\n\t\t\treturn fmt.Errorf(\"ins.AccountMetaSlice[%v] is not set\", accIndex)\n\t\t}\n\t}\n")
+	b.WriteString("\treturn nil\n}\n\n")
+
+	fmt.Fprintf(&b, "func (inst *%s) EncodeToTree(parent ag_treeout.Branches) {\n", ix.GoName)
+	b.WriteString("\tparent.Child(ag_format.Program(ProgramName, ProgramID)).\n")
+	b.WriteString("\t\tParentFunc(func(programBranch ag_treeout.Branches) {\n")
+	fmt.Fprintf(&b, "\t\t\tprogramBranch.Child(ag_format.Instruction(%q)).\n", ix.GoName)
+	b.WriteString("\t\t\t\tParentFunc(func(instructionBranch ag_treeout.Branches) {\n")
+	b.WriteString("\t\t\t\t\tinstructionBranch.Child(\"Params\").ParentFunc(func(paramsBranch ag_treeout.Branches) {\n")
+	for _, arg := range ix.Args {
+		fmt.Fprintf(&b, "\t\t\t\t\t\tparamsBranch.Child(ag_format.Param(%q, *inst.%s))\n", arg.GoName, arg.GoName)
+	}
+	b.WriteString("\t\t\t\t\t})\n")
+	b.WriteString("\t\t\t\t\tinstructionBranch.Child(\"Accounts\").ParentFunc(func(accountsBranch ag_treeout.Branches) {\n")
+	for idx, acc := range ix.Accounts {
+		fmt.Fprintf(&b, "\t\t\t\t\t\taccountsBranch.Child(ag_format.Meta(%q, inst.AccountMetaSlice.Get(%d)))\n", acc.IDLName, idx)
+	}
+	b.WriteString("\t\t\t\t\t})\n\t\t\t\t})\n\t\t})\n}\n\n")
+
+	fmt.Fprintf(&b, "func (obj %s) MarshalWithEncoder(encoder *ag_binary.Encoder) (err error) {\n", ix.GoName)
+	for _, arg := range ix.Args {
+		fmt.Fprintf(&b, "\tif err = encoder.Encode(*obj.%s); err != nil {\n\t\treturn err\n\t}\n", arg.GoName)
+	}
+	b.WriteString("\treturn nil\n}\n\n")
+
+	fmt.Fprintf(&b, "func (obj *%s) UnmarshalWithDecoder(decoder *ag_binary.Decoder) (err error) {\n", ix.GoName)
+	for _, arg := range ix.Args {
+		fmt.Fprintf(&b, "\tif err = decoder.Decode(&obj.%s); err != nil {\n\t\treturn err\n\t}\n", arg.GoName)
+	}
+	b.WriteString("\treturn nil\n}\n")
+
+	return b.String(), nil
+}
+
+// accountFlags renders acc's access flags for the doc-comment table atop
+// an instruction's account field, e.g. "WRITE, SIGNER".
+func accountFlags(acc idl.InstructionAccount) string {
+	var flags []string
+	if acc.Writable {
+		flags = append(flags, "WRITE")
+	}
+	if acc.Signer {
+		flags = append(flags, "SIGNER")
+	}
+	return strings.Join(flags, ", ")
+}
+
+// accountMetaExpr renders the ag_solanago.Meta(...) expression used to
+// set an instruction's account at build time, applying .WRITE()/.SIGNER()
+// as acc requires.
+func accountMetaExpr(paramName string, acc idl.InstructionAccount) string {
+	expr := fmt.Sprintf("ag_solanago.Meta(%s)", paramName)
+	if acc.Writable {
+		expr += ".WRITE()"
+	}
+	if acc.Signer {
+		expr += ".SIGNER()"
+	}
+	return expr
+}
@@ -0,0 +1,91 @@
+package renderer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/codama-idl/renderers-go/idl"
+)
+
+// PDA renders pump/generated/pda.go: a Find<Name>Address and
+// MustFind<Name>Address helper per account the IDL marks as
+// PDA-derived, wrapping solana.FindProgramAddress with the account's
+// IDL-declared seeds.
+func PDA(i *idl.IDL) (string, error) {
+	var b strings.Builder
+
+	b.WriteString("package pump\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\tag_solanago \"github.com/gagliardetto/solana-go\"\n")
+	b.WriteString(")\n\n")
+
+	for _, acc := range i.Accounts {
+		if len(acc.Seeds) == 0 {
+			continue
+		}
+
+		prefixVar := lowerFirst(acc.Name) + "SeedPrefix"
+		constSeeds := 0
+		for _, s := range acc.Seeds {
+			if s.Account == "" {
+				constSeeds++
+			}
+		}
+		if constSeeds == 1 {
+			fmt.Fprintf(&b, "// %s is the fixed seed the program uses to derive a\n", prefixVar)
+			fmt.Fprintf(&b, "// %s's %s PDA.\n", seedAccountArgNames(acc.Seeds), acc.Name)
+			var constVal []byte
+			for _, s := range acc.Seeds {
+				if s.Account == "" {
+					constVal = s.Const
+				}
+			}
+			fmt.Fprintf(&b, "var %s = []byte(%q)\n\n", prefixVar, string(constVal))
+		}
+
+		params := accountSeedParamList(acc.Seeds)
+
+		fmt.Fprintf(&b, "// Find%sAddress derives the %s PDA for %s.\n", acc.Name, acc.Name, seedAccountArgNames(acc.Seeds))
+		fmt.Fprintf(&b, "func Find%sAddress(%s) (ag_solanago.PublicKey, uint8, error) {\n", acc.Name, params)
+		b.WriteString("\treturn ag_solanago.FindProgramAddress(\n\t\t[][]byte{\n")
+		for _, s := range acc.Seeds {
+			if s.Account != "" {
+				fmt.Fprintf(&b, "\t\t\t%s.Bytes(),\n", s.Account)
+			} else {
+				fmt.Fprintf(&b, "\t\t\t%s,\n", prefixVar)
+			}
+		}
+		b.WriteString("\t\t},\n\t\tProgramID,\n\t)\n}\n\n")
+
+		fmt.Fprintf(&b, "// MustFind%sAddress derives the %s PDA for %s,\n", acc.Name, acc.Name, seedAccountArgNames(acc.Seeds))
+		b.WriteString("// panicking if no valid bump seed can be found.\n")
+		fmt.Fprintf(&b, "func MustFind%sAddress(%s) ag_solanago.PublicKey {\n", acc.Name, params)
+		argNames := seedAccountArgNames(acc.Seeds)
+		fmt.Fprintf(&b, "\tpda, _, err := Find%sAddress(%s)\n", acc.Name, argNames)
+		b.WriteString("\tif err != nil {\n\t\tpanic(err)\n\t}\n\treturn pda\n}\n\n")
+	}
+
+	return b.String(), nil
+}
+
+// accountSeedParamList renders the Go parameter list for the
+// account-derived seeds in seeds, e.g. "mint ag_solanago.PublicKey".
+func accountSeedParamList(seeds []idl.Seed) string {
+	var params []string
+	for _, s := range seeds {
+		if s.Account != "" {
+			params = append(params, s.Account+" ag_solanago.PublicKey")
+		}
+	}
+	return strings.Join(params, ", ")
+}
+
+func seedAccountArgNames(seeds []idl.Seed) string {
+	var names []string
+	for _, s := range seeds {
+		if s.Account != "" {
+			names = append(names, s.Account)
+		}
+	}
+	return strings.Join(names, ", ")
+}
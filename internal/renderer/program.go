@@ -0,0 +1,46 @@
+package renderer
+
+import (
+	"strings"
+
+	"github.com/codama-idl/renderers-go/idl"
+)
+
+// Program renders pump/generated/program.go: the program ID, its
+// human-readable name, and the instruction-decoder registration needed
+// for solana.DecodeInstruction (and Transaction.EncodeToTree) to find
+// this program's instructions.
+func Program(i *idl.IDL) (string, error) {
+	var b strings.Builder
+
+	b.WriteString("// Package pump contains the Go client generated by codama-idl/renderers-go\n")
+	b.WriteString("// for the pump.fun Anchor program, from the IDL in idl/pump.json.\n")
+	b.WriteString("package pump\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\tag_solanago \"github.com/gagliardetto/solana-go\"\n")
+	b.WriteString(")\n\n")
+
+	b.WriteString("// ProgramName is the human-readable name of the program, used in\n")
+	b.WriteString("// EncodeToTree output.\n")
+	b.WriteString("const ProgramName = \"Pump\"\n\n")
+
+	b.WriteString("// ProgramID is the deployed address of the pump.fun program on mainnet-beta.\n")
+	b.WriteString("var ProgramID = ag_solanago.MustPublicKeyFromBase58(\"6EF8rrecthR5Dkzon8Nwu78hRvfCKubJ14M5uBEwF6P\")\n\n")
+
+	b.WriteString("// SetProgramID overrides the default ProgramID, for use against a\n")
+	b.WriteString("// different deployment (e.g. devnet or a local validator).\n")
+	b.WriteString("func SetProgramID(pubkey ag_solanago.PublicKey) {\n\tProgramID = pubkey\n}\n\n")
+
+	if len(i.Instructions) > 0 {
+		b.WriteString("func init() {\n")
+		b.WriteString("\tag_solanago.MustRegisterInstructionDecoder(ProgramID, registryDecodeInstruction)\n}\n\n")
+
+		b.WriteString("// registryDecodeInstruction adapts DecodeInstruction to the\n")
+		b.WriteString("// solana.InstructionDecoder signature, so solana.DecodeInstruction (and\n")
+		b.WriteString("// in turn Transaction.EncodeTree) can find this program's instructions.\n")
+		b.WriteString("func registryDecodeInstruction(accounts []*ag_solanago.AccountMeta, data []byte) (interface{}, error) {\n")
+		b.WriteString("\treturn DecodeInstruction(accounts, data)\n}\n")
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,52 @@
+// Package renderer generates the pump package's Go source from the
+// parsed Codama IDL in the idl package. cmd/renderer-go is the CLI that
+// writes its output to pump/generated.
+package renderer
+
+import (
+	"fmt"
+	"go/format"
+	"strings"
+
+	"github.com/codama-idl/renderers-go/idl"
+)
+
+// generatedHeader is prepended to every file this package renders.
+const generatedHeader = "// Code generated by renderer-go from idl/pump.json. DO NOT EDIT.\n\n"
+
+// Format gofmt's src and prepends the generated-code header. Callers
+// pass it the raw, not-necessarily-aligned source one of this package's
+// render functions (Program, Accounts, Events, ...) built; Format is what makes indentation/spacing renderer-output
+// actually match gofmt's style.
+func Format(src string) ([]byte, error) {
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		return nil, fmt.Errorf("renderer: formatting generated source: %w", err)
+	}
+	return append([]byte(generatedHeader), formatted...), nil
+}
+
+// typeIDLiteral renders d as an ag_binary.TypeID composite literal.
+func typeIDLiteral(d [8]byte) string {
+	parts := make([]string, len(d))
+	for i, b := range d {
+		parts[i] = fmt.Sprintf("%d", b)
+	}
+	return "ag_binary.TypeID{" + strings.Join(parts, ", ") + "}"
+}
+
+// derefExpr returns the expression used to read a Borsh-encoded field
+// value out of a builder whose fields are stored as pointers (so a
+// Validate() can tell "unset" apart from the zero value).
+func derefExpr(field idl.Field) string {
+	return "*obj." + field.GoName
+}
+
+// lowerFirst lowercases s's first rune, for turning an exported Go name
+// into the unexported variant (e.g. "BondingCurve" -> "bondingCurve").
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,87 @@
+package renderer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/codama-idl/renderers-go/idl"
+)
+
+// Subscribe renders pump/generated/subscribe.go: a WebSocket
+// AccountSubscribe wrapper, with reconnect/backoff, per account the IDL
+// declares.
+func Subscribe(i *idl.IDL) (string, error) {
+	var b strings.Builder
+
+	b.WriteString("package pump\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\t\"context\"\n")
+	b.WriteString("\t\"time\"\n\n")
+	b.WriteString("\tag_binary \"github.com/gagliardetto/binary\"\n")
+	b.WriteString("\tag_solanago \"github.com/gagliardetto/solana-go\"\n")
+	b.WriteString("\t\"github.com/gagliardetto/solana-go/rpc\"\n")
+	b.WriteString("\t\"github.com/gagliardetto/solana-go/rpc/ws\"\n")
+	b.WriteString(")\n\n")
+
+	b.WriteString("// Backoff bounds for the reconnect loop in the Subscribe* helpers below.\n")
+	b.WriteString("const (\n")
+	b.WriteString("\tsubscribeMinBackoff = 250 * time.Millisecond\n")
+	b.WriteString("\tsubscribeMaxBackoff = 30 * time.Second\n")
+	b.WriteString(")\n\n")
+
+	b.WriteString("func nextBackoff(cur time.Duration) time.Duration {\n")
+	b.WriteString("\tnext := cur * 2\n")
+	b.WriteString("\tif next > subscribeMaxBackoff {\n\t\treturn subscribeMaxBackoff\n\t}\n")
+	b.WriteString("\treturn next\n}\n\n")
+
+	for _, acc := range i.Accounts {
+		fmt.Fprintf(&b, "// %sUpdate pairs a decoded %s with the slot at which\n", acc.Name, acc.Name)
+		b.WriteString("// the notification was observed.\n")
+		fmt.Fprintf(&b, "type %sUpdate struct {\n", acc.Name)
+		b.WriteString("\tSlot    uint64\n")
+		fmt.Fprintf(&b, "\tAccount *%s\n", acc.Name)
+		b.WriteString("}\n\n")
+
+		fmt.Fprintf(&b, "// Subscribe%s streams decoded %s updates for the\n", acc.Name, acc.Name)
+		b.WriteString("// account at pubkey over wsClient's AccountSubscribe API. The returned\n")
+		b.WriteString("// channel is closed once ctx is done. Dropped WebSocket connections are\n")
+		b.WriteString("// retried with exponential backoff, since the underlying mainnet\n")
+		b.WriteString("// connection is not expected to stay up indefinitely.\n")
+		fmt.Fprintf(&b, "func Subscribe%s(ctx context.Context, wsClient *ws.Client, pubkey ag_solanago.PublicKey) (<-chan *%sUpdate, error) {\n", acc.Name, acc.Name)
+		b.WriteString("\tsub, err := wsClient.AccountSubscribe(pubkey, rpc.CommitmentConfirmed)\n")
+		b.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+		fmt.Fprintf(&b, "\tupdates := make(chan *%sUpdate)\n\n", acc.Name)
+		b.WriteString("\tgo func() {\n")
+		b.WriteString("\t\tdefer close(updates)\n")
+		b.WriteString("\t\tdefer func() {\n\t\t\tif sub != nil {\n\t\t\t\tsub.Unsubscribe()\n\t\t\t}\n\t\t}()\n\n")
+		b.WriteString("\t\tbackoff := subscribeMinBackoff\n")
+		b.WriteString("\t\tfor {\n")
+		b.WriteString("\t\t\tgot, err := sub.Recv(ctx)\n")
+		b.WriteString("\t\t\tif err != nil {\n")
+		b.WriteString("\t\t\t\tif ctx.Err() != nil {\n\t\t\t\t\treturn\n\t\t\t\t}\n\n")
+		b.WriteString("\t\t\t\tsub.Unsubscribe()\n")
+		b.WriteString("\t\t\t\tsub = nil\n")
+		b.WriteString("\t\t\t\tfor {\n")
+		b.WriteString("\t\t\t\t\tselect {\n")
+		b.WriteString("\t\t\t\t\tcase <-ctx.Done():\n\t\t\t\t\t\treturn\n")
+		b.WriteString("\t\t\t\t\tcase <-time.After(backoff):\n\t\t\t\t\t}\n\n")
+		b.WriteString("\t\t\t\t\tsub, err = wsClient.AccountSubscribe(pubkey, rpc.CommitmentConfirmed)\n")
+		b.WriteString("\t\t\t\t\tif err == nil {\n\t\t\t\t\t\tbreak\n\t\t\t\t\t}\n")
+		b.WriteString("\t\t\t\t\tbackoff = nextBackoff(backoff)\n\t\t\t\t}\n")
+		b.WriteString("\t\t\t\tbackoff = subscribeMinBackoff\n\t\t\t\tcontinue\n\t\t\t}\n")
+		b.WriteString("\t\t\tbackoff = subscribeMinBackoff\n\n")
+		fmt.Fprintf(&b, "\t\t\tvar acc %s\n", acc.Name)
+		b.WriteString("\t\t\tdecoder := ag_binary.NewBorshDecoder(got.Value.Data.GetBinary())\n")
+		b.WriteString("\t\t\tif err := acc.UnmarshalWithDecoder(decoder); err != nil {\n")
+		fmt.Fprintf(&b, "\t\t\t\t// Skip payloads that don't decode as a %s\n", acc.Name)
+		b.WriteString("\t\t\t\t// (e.g. a stale notification for a closed account).\n")
+		b.WriteString("\t\t\t\tcontinue\n\t\t\t}\n\n")
+		b.WriteString("\t\t\tselect {\n")
+		fmt.Fprintf(&b, "\t\t\tcase updates <- &%sUpdate{Slot: got.Context.Slot, Account: &acc}:\n", acc.Name)
+		b.WriteString("\t\t\tcase <-ctx.Done():\n\t\t\t\treturn\n\t\t\t}\n")
+		b.WriteString("\t\t}\n\t}()\n\n")
+		b.WriteString("\treturn updates, nil\n}\n\n")
+	}
+
+	return b.String(), nil
+}
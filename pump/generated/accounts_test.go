@@ -0,0 +1,50 @@
+package pump
+
+import (
+	"bytes"
+	"testing"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+)
+
+func TestBondingCurveMarshalUnmarshalRoundTrip(t *testing.T) {
+	want := BondingCurve{
+		VirtualTokenReserves: 1_000_000,
+		VirtualSolReserves:   2_000_000,
+		RealTokenReserves:    3_000_000,
+		RealSolReserves:      4_000_000,
+		TokenTotalSupply:     5_000_000,
+		Complete:             true,
+		Creator:              ag_solanago.SystemProgramID,
+		IsMayhemMode:         false,
+	}
+
+	buf := new(bytes.Buffer)
+	if err := want.MarshalWithEncoder(ag_binary.NewBorshEncoder(buf)); err != nil {
+		t.Fatalf("MarshalWithEncoder: %v", err)
+	}
+
+	var got BondingCurve
+	if err := got.UnmarshalWithDecoder(ag_binary.NewBorshDecoder(buf.Bytes())); err != nil {
+		t.Fatalf("UnmarshalWithDecoder: %v", err)
+	}
+
+	if got != want {
+		t.Fatalf("round-trip mismatch: got %+v, want %+v", got, want)
+	}
+}
+
+func TestBondingCurveUnmarshalRejectsWrongDiscriminator(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := buf.WriteByte(0); err != nil {
+		t.Fatal(err)
+	}
+	buf.Write(make([]byte, 7))
+
+	var got BondingCurve
+	err := got.UnmarshalWithDecoder(ag_binary.NewBorshDecoder(buf.Bytes()))
+	if err == nil {
+		t.Fatal("expected an error for a mismatched discriminator, got nil")
+	}
+}
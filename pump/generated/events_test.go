@@ -0,0 +1,81 @@
+package pump
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+)
+
+func encodeTradeEvent(t *testing.T, ev TradeEvent) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	encoder := ag_binary.NewBorshEncoder(buf)
+	if err := encoder.WriteBytes(Event_Trade[:], false); err != nil {
+		t.Fatal(err)
+	}
+	if err := encoder.Encode(ev); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecodeEventTrade(t *testing.T) {
+	want := TradeEvent{
+		Mint:                 ag_solanago.SystemProgramID,
+		SolAmount:            1,
+		TokenAmount:          2,
+		IsBuy:                true,
+		User:                 ag_solanago.SystemProgramID,
+		Timestamp:            3,
+		VirtualSolReserves:   4,
+		VirtualTokenReserves: 5,
+	}
+
+	got, err := DecodeEvent(encodeTradeEvent(t, want))
+	if err != nil {
+		t.Fatalf("DecodeEvent: %v", err)
+	}
+
+	trade, ok := got.(*TradeEvent)
+	if !ok {
+		t.Fatalf("DecodeEvent returned %T, want *TradeEvent", got)
+	}
+	if *trade != want {
+		t.Fatalf("decoded event mismatch: got %+v, want %+v", *trade, want)
+	}
+}
+
+func TestDecodeEventUnknownDiscriminator(t *testing.T) {
+	data := append(make([]byte, 8), 0)
+	if _, err := DecodeEvent(data); err == nil {
+		t.Fatal("expected an error for an unknown event discriminator, got nil")
+	}
+}
+
+func TestParseEventsSkipsNonProgramDataLines(t *testing.T) {
+	want := TradeEvent{SolAmount: 42}
+	encoded := base64.StdEncoding.EncodeToString(encodeTradeEvent(t, want))
+
+	logs := []string{
+		"Program log: unrelated",
+		programDataLogPrefix + encoded,
+		"Program data: not-base64!!",
+	}
+
+	events := ParseEvents(logs)
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+
+	trade, ok := events[0].(*TradeEvent)
+	if !ok {
+		t.Fatalf("event 0 is %T, want *TradeEvent", events[0])
+	}
+	if trade.SolAmount != want.SolAmount {
+		t.Fatalf("got SolAmount %d, want %d", trade.SolAmount, want.SolAmount)
+	}
+}
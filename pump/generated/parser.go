@@ -0,0 +1,118 @@
+package pump
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	ag_solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// ParsedInstruction is a decoded pump instruction, along with the index
+// of the top-level transaction instruction it came from.
+type ParsedInstruction struct {
+	Index       int
+	Instruction *Instruction
+}
+
+// ParseTransaction walks tx's top-level instructions and decodes every
+// one whose program ID is ProgramID.
+func ParseTransaction(tx *ag_solanago.Transaction) ([]ParsedInstruction, error) {
+	var out []ParsedInstruction
+
+	for i, ix := range tx.Message.Instructions {
+		programID, err := tx.Message.Program(ix.ProgramIDIndex)
+		if err != nil {
+			return nil, fmt.Errorf("resolving program id for instruction %d: %w", i, err)
+		}
+		if !programID.Equals(ProgramID) {
+			continue
+		}
+
+		accounts, err := ix.ResolveInstructionAccounts(&tx.Message)
+		if err != nil {
+			return nil, fmt.Errorf("resolving accounts for instruction %d: %w", i, err)
+		}
+
+		decoded, err := DecodeInstruction(accounts, ix.Data)
+		if err != nil {
+			return nil, fmt.Errorf("decoding instruction %d: %w", i, err)
+		}
+
+		out = append(out, ParsedInstruction{Index: i, Instruction: decoded})
+	}
+
+	return out, nil
+}
+
+// ParseInnerInstructions decodes pump instructions nested inside tx's
+// inner (CPI) instructions, as reported by meta.
+func ParseInnerInstructions(tx *ag_solanago.Transaction, meta *rpc.TransactionMeta) ([]ParsedInstruction, error) {
+	var out []ParsedInstruction
+
+	// meta reports inner instructions as rpc.CompiledInstruction, which
+	// (unlike solana.CompiledInstruction) has no ResolveInstructionAccounts
+	// helper, so accounts are resolved by hand against the full metas list.
+	metas, err := tx.Message.AccountMetaList()
+	if err != nil {
+		return nil, fmt.Errorf("resolving account metas: %w", err)
+	}
+
+	for _, inner := range meta.InnerInstructions {
+		for _, ix := range inner.Instructions {
+			programID, err := tx.Message.Program(ix.ProgramIDIndex)
+			if err != nil {
+				return nil, fmt.Errorf("resolving program id for inner instruction: %w", err)
+			}
+			if !programID.Equals(ProgramID) {
+				continue
+			}
+
+			accounts := make([]*ag_solanago.AccountMeta, len(ix.Accounts))
+			for i, acctIndex := range ix.Accounts {
+				accounts[i] = metas[acctIndex]
+			}
+
+			decoded, err := DecodeInstruction(accounts, ix.Data)
+			if err != nil {
+				return nil, fmt.Errorf("decoding inner instruction: %w", err)
+			}
+
+			out = append(out, ParsedInstruction{Index: int(inner.Index), Instruction: decoded})
+		}
+	}
+
+	return out, nil
+}
+
+// programDataLogPrefix is how the Solana runtime tags an Anchor `emit!`
+// log entry.
+const programDataLogPrefix = "Program data: "
+
+// ParseEvents scans a transaction's log messages for base64-encoded
+// "Program data:" lines and decodes each into its typed event. Lines
+// that don't decode as a known pump event are skipped.
+func ParseEvents(logMessages []string) []interface{} {
+	var events []interface{}
+
+	for _, line := range logMessages {
+		if !strings.HasPrefix(line, programDataLogPrefix) {
+			continue
+		}
+
+		raw, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(line, programDataLogPrefix))
+		if err != nil {
+			continue
+		}
+
+		ev, err := DecodeEvent(raw)
+		if err != nil {
+			continue
+		}
+
+		events = append(events, ev)
+	}
+
+	return events
+}
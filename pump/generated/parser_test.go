@@ -0,0 +1,94 @@
+package pump
+
+import (
+	"testing"
+
+	ag_solanago "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func buyInstructionData(t *testing.T) []byte {
+	t.Helper()
+
+	inst := NewBuyInstructionBuilder().SetAmount(100).SetMaxSolCost(200).Build()
+	data, err := inst.Data()
+	if err != nil {
+		t.Fatalf("encoding Buy data: %v", err)
+	}
+	return data
+}
+
+func newTestMessage(data []byte) ag_solanago.Message {
+	user := ag_solanago.MustPublicKeyFromBase58("11111111111111111111111111111112")
+
+	return ag_solanago.Message{
+		AccountKeys: ag_solanago.PublicKeySlice{user, ProgramID},
+		Header: ag_solanago.MessageHeader{
+			NumRequiredSignatures:       1,
+			NumReadonlySignedAccounts:   0,
+			NumReadonlyUnsignedAccounts: 1,
+		},
+		Instructions: []ag_solanago.CompiledInstruction{
+			{
+				ProgramIDIndex: 1,
+				Accounts:       []uint16{0},
+				Data:           data,
+			},
+		},
+	}
+}
+
+func TestParseTransactionDecodesPumpInstruction(t *testing.T) {
+	tx := &ag_solanago.Transaction{Message: newTestMessage(buyInstructionData(t))}
+
+	parsed, err := ParseTransaction(tx)
+	if err != nil {
+		t.Fatalf("ParseTransaction: %v", err)
+	}
+	if len(parsed) != 1 {
+		t.Fatalf("got %d parsed instructions, want 1", len(parsed))
+	}
+	if parsed[0].Index != 0 {
+		t.Fatalf("got index %d, want 0", parsed[0].Index)
+	}
+
+	buy, ok := parsed[0].Instruction.Impl.(*Buy)
+	if !ok {
+		t.Fatalf("decoded instruction is %T, want *Buy", parsed[0].Instruction.Impl)
+	}
+	if *buy.Amount != 100 || *buy.MaxSolCost != 200 {
+		t.Fatalf("got Buy{%d,%d}, want Buy{100,200}", *buy.Amount, *buy.MaxSolCost)
+	}
+}
+
+func TestParseInnerInstructionsDecodesPumpInstruction(t *testing.T) {
+	tx := &ag_solanago.Transaction{Message: newTestMessage(nil)}
+	meta := &rpc.TransactionMeta{
+		InnerInstructions: []rpc.InnerInstruction{
+			{
+				Index: 3,
+				Instructions: []rpc.CompiledInstruction{
+					{
+						ProgramIDIndex: 1,
+						Accounts:       []uint16{0},
+						Data:           buyInstructionData(t),
+					},
+				},
+			},
+		},
+	}
+
+	parsed, err := ParseInnerInstructions(tx, meta)
+	if err != nil {
+		t.Fatalf("ParseInnerInstructions: %v", err)
+	}
+	if len(parsed) != 1 {
+		t.Fatalf("got %d parsed instructions, want 1", len(parsed))
+	}
+	if parsed[0].Index != 3 {
+		t.Fatalf("got index %d, want 3", parsed[0].Index)
+	}
+	if _, ok := parsed[0].Instruction.Impl.(*Buy); !ok {
+		t.Fatalf("decoded instruction is %T, want *Buy", parsed[0].Instruction.Impl)
+	}
+}
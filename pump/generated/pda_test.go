@@ -0,0 +1,41 @@
+package pump
+
+import (
+	"testing"
+
+	ag_solanago "github.com/gagliardetto/solana-go"
+)
+
+func TestFindBondingCurveAddressIsDeterministic(t *testing.T) {
+	mint := ag_solanago.MustPublicKeyFromBase58("So11111111111111111111111111111111111111112")
+
+	pda1, bump1, err := FindBondingCurveAddress(mint)
+	if err != nil {
+		t.Fatalf("FindBondingCurveAddress: %v", err)
+	}
+
+	pda2, bump2, err := FindBondingCurveAddress(mint)
+	if err != nil {
+		t.Fatalf("FindBondingCurveAddress: %v", err)
+	}
+
+	if pda1 != pda2 || bump1 != bump2 {
+		t.Fatalf("derivation isn't deterministic: got (%s,%d) then (%s,%d)", pda1, bump1, pda2, bump2)
+	}
+
+	if pda1 == mint {
+		t.Fatal("derived PDA must not equal the mint it was derived from")
+	}
+}
+
+func TestFindBondingCurveAddressDiffersByMint(t *testing.T) {
+	mintA := ag_solanago.MustPublicKeyFromBase58("So11111111111111111111111111111111111111112")
+	mintB := ag_solanago.SystemProgramID
+
+	pdaA := MustFindBondingCurveAddress(mintA)
+	pdaB := MustFindBondingCurveAddress(mintB)
+
+	if pdaA == pdaB {
+		t.Fatal("different mints must derive different BondingCurve PDAs")
+	}
+}